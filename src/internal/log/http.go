@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
@@ -30,7 +31,8 @@ func AddLoggerToHTTPServer(rctx context.Context, name string, s *http.Server) {
 			}
 			id := zap.Strings("x-request-id", requestID)
 
-			if ua := r.Header.Get("user-agent"); ua != "Envoy/HC" {
+			ua := r.Header.Get("user-agent")
+			if ua != "Envoy/HC" {
 				// Print info about the request if this is not an Envoy health check.
 				url := r.URL.Path
 				if len(url) > 16384 {
@@ -43,7 +45,58 @@ func AddLoggerToHTTPServer(rctx context.Context, name string, s *http.Server) {
 			ctx = ChildLogger(ctx, "", WithFields(id))
 			ctx = metadata.NewOutgoingContext(ctx, metadata.MD{"x-request-id": requestID})
 			r = r.WithContext(ctx)
-			orig.ServeHTTP(w, r)
+			rw := &responseRecorder{ResponseWriter: w}
+			start := time.Now()
+			orig.ServeHTTP(rw, r)
+			if ua != "Envoy/HC" {
+				Info(ctx, "http response", zap.Int("code", rw.code()), zap.Int64("bytes", rw.bytes), zap.Duration("duration", time.Since(start)))
+			}
 		})
 	}
 }
+
+// responseRecorder is an http.ResponseWriter that records the status code and number of body
+// bytes written, so that they can be logged when the request completes.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *responseRecorder) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseRecorder) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.bytes += int64(n)
+	return n, err //nolint:wrapcheck
+}
+
+// Flush implements http.Flusher; handlers that stream responses (like the archive server) type
+// assert for it.
+func (w *responseRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap allows http.ResponseController to reach the underlying ResponseWriter.
+func (w *responseRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// code returns the status code sent to the client. A handler that never writes a header or body
+// results in an implicit 200.
+func (w *responseRecorder) code() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -35,8 +36,35 @@ func TestHTTPServer(t *testing.T) {
 	want := []string{
 		"http: info: incoming http request",
 		"http: info: log from handler",
+		"http: info: http response",
 	}
 	if diff := cmp.Diff(h.Logs(), want, formatLogs(simple)); diff != "" {
 		t.Errorf("logs (-got +want):\n%s", diff)
 	}
 }
+
+func TestResponseRecorder(t *testing.T) {
+	hr := httptest.NewRecorder()
+	rw := &responseRecorder{ResponseWriter: hr}
+	if got, want := rw.code(), http.StatusOK; got != want {
+		t.Errorf("code before write: got %v want %v", got, want)
+	}
+	rw.WriteHeader(http.StatusTeapot)
+	for _, b := range []string{"hello, ", "world"} {
+		if _, err := rw.Write([]byte(b)); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	var _ http.Flusher = rw
+	rw.Flush()
+
+	if got, want := rw.code(), http.StatusTeapot; got != want {
+		t.Errorf("code: got %v want %v", got, want)
+	}
+	if got, want := rw.bytes, int64(len("hello, world")); got != want {
+		t.Errorf("bytes: got %v want %v", got, want)
+	}
+	if !hr.Flushed {
+		t.Error("underlying ResponseWriter was not flushed")
+	}
+}
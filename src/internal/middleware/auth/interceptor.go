@@ -93,6 +93,14 @@ var authHandlers = map[string]authHandler{
 	"/grpc.health.v1.Health/Check": unauthenticated,
 	"/grpc.health.v1.Health/Watch": unauthenticated,
 
+	//
+	// Reflection API
+	//
+
+	// Reflection only describes the API schema, which is public
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      unauthenticated,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": unauthenticated,
+
 	//
 	// Identity API
 	//
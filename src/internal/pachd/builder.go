@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/pachyderm/pachyderm/v2/src/admin"
 	"github.com/pachyderm/pachyderm/v2/src/auth"
@@ -315,6 +316,14 @@ func (b *builder) registerHealthServer(ctx context.Context) error {
 	return nil
 }
 
+// registerReflectionServer registers the gRPC server reflection service, which
+// describes every registered service and message so that tools like grpcurl
+// and client generators for other languages can discover the API.
+func (b *builder) registerReflectionServer(ctx context.Context) error {
+	b.forGRPCServer(func(s *grpc.Server) { reflection.Register(s) })
+	return nil
+}
+
 func (b *builder) registerVersionServer(ctx context.Context) error {
 	b.forGRPCServer(func(s *grpc.Server) {
 		versionpb.RegisterAPIServer(s, version.NewAPIServer(version.Version, version.APIServerOptions{}))
@@ -71,6 +71,7 @@ func (eb *enterpriseBuilder) buildAndRun(ctx context.Context) error {
 		eb.registerHealthServer,
 		eb.registerAdminServer,
 		eb.registerVersionServer,
+		eb.registerReflectionServer,
 
 		eb.initTransaction,
 		eb.internallyListen,
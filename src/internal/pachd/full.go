@@ -85,6 +85,7 @@ func (fb *fullBuilder) buildAndRun(ctx context.Context) error {
 		fb.registerAdminServer,
 		fb.registerHealthServer,
 		fb.registerVersionServer,
+		fb.registerReflectionServer,
 		fb.registerDebugServer,
 		fb.registerProxyServer,
 		fb.initS3Server,